@@ -0,0 +1,232 @@
+package channels
+
+// Filter returns a channel that produces only the elements of ch for which
+// pred returns true.
+func Filter[A any](ch <-chan A, pred func(A) bool) <-chan A {
+	out := make(chan A)
+	go func() {
+		defer close(out)
+		for value := range ch {
+			if pred(value) {
+				out <- value
+			}
+		}
+	}()
+	return out
+}
+
+// FilterUntil returns a channel that produces only the elements of ch for
+// which pred returns true.
+// It is cancelled if the supplied done channel is closed before the operation has completed.
+func FilterUntil[A any](done <-chan struct{}, ch <-chan A, pred func(A) bool) <-chan A {
+	out := make(chan A)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			select {
+			case <-done:
+				return
+			case value, ok := <-ch:
+				if !ok {
+					return
+				}
+				if pred(value) {
+					select {
+					case <-done:
+						return
+					case out <- value:
+					}
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// Pair holds corresponding elements produced by Zip and ZipUntil.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip combines corresponding elements from a and b into a single channel of
+// Pairs, stopping as soon as either input channel is exhausted.
+func Zip[A any, B any](a <-chan A, b <-chan B) <-chan Pair[A, B] {
+	out := make(chan Pair[A, B])
+	go func() {
+		defer close(out)
+		for {
+			first, ok := <-a
+			if !ok {
+				return
+			}
+			second, ok := <-b
+			if !ok {
+				return
+			}
+			out <- Pair[A, B]{First: first, Second: second}
+		}
+	}()
+	return out
+}
+
+// ZipUntil combines corresponding elements from a and b into a single
+// channel of Pairs, stopping as soon as either input channel is exhausted.
+// It is cancelled if the supplied done channel is closed before the operation has completed.
+func ZipUntil[A any, B any](done <-chan struct{}, a <-chan A, b <-chan B) <-chan Pair[A, B] {
+	out := make(chan Pair[A, B])
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-done:
+				return
+			case first, ok := <-a:
+				if !ok {
+					return
+				}
+				select {
+				case <-done:
+					return
+				case second, ok := <-b:
+					if !ok {
+						return
+					}
+					select {
+					case <-done:
+						return
+					case out <- (Pair[A, B]{First: first, Second: second}):
+					}
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// splitBufferSize is the per-consumer buffer used by Split and SplitUntil so
+// that one slow consumer does not stall delivery to the others until its
+// own buffer fills up.
+const splitBufferSize = 16
+
+// Split broadcasts every value from ch to n independent output channels, so
+// that each of n consumers sees every value. Each output channel is
+// buffered with splitBufferSize capacity; once a consumer's buffer is full,
+// Split applies back-pressure to the broadcast rather than dropping values.
+func Split[A any](ch <-chan A, n int) []<-chan A {
+	outs := make([]chan A, n)
+	result := make([]<-chan A, n)
+	for i := range outs {
+		outs[i] = make(chan A, splitBufferSize)
+		result[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		for value := range ch {
+			for _, out := range outs {
+				out <- value
+			}
+		}
+	}()
+
+	return result
+}
+
+// SplitUntil broadcasts every value from ch to n independent output
+// channels, as Split does.
+// It is cancelled if the supplied done channel is closed before the operation has completed.
+func SplitUntil[A any](done <-chan struct{}, ch <-chan A, n int) []<-chan A {
+	outs := make([]chan A, n)
+	result := make([]<-chan A, n)
+	for i := range outs {
+		outs[i] = make(chan A, splitBufferSize)
+		result[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		for {
+			select {
+			case <-done:
+				return
+			case value, ok := <-ch:
+				if !ok {
+					return
+				}
+				for _, out := range outs {
+					select {
+					case <-done:
+						return
+					case out <- value:
+					}
+				}
+			}
+		}
+	}()
+
+	return result
+}
+
+// Scan combines values from ch into a running accumulator using f, seeded
+// with seed, and emits the accumulator after every element.
+// Unlike Aggregate, which emits only the final result, Scan emits each
+// intermediate result as it is produced.
+func Scan[A any, R any](ch <-chan A, seed R, f func(A, R) R) <-chan R {
+	out := make(chan R)
+	go func() {
+		defer close(out)
+		result := seed
+
+		for value := range ch {
+			result = f(value, result)
+			out <- result
+		}
+	}()
+	return out
+}
+
+// ScanUntil combines values from ch into a running accumulator using f,
+// seeded with seed, and emits the accumulator after every element.
+// It is cancelled if the supplied done channel is closed before the operation has completed.
+func ScanUntil[A any, R any](done <-chan struct{}, ch <-chan A, seed R, f func(A, R) R) <-chan R {
+	out := make(chan R)
+	go func() {
+		defer close(out)
+		result := seed
+
+		for {
+			select {
+			case <-done:
+				return
+			case value, ok := <-ch:
+				if !ok {
+					return
+				}
+				result = f(value, result)
+				select {
+				case <-done:
+					return
+				case out <- result:
+				}
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,148 @@
+package channels
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(x int) bool {
+	return x%2 == 0
+}
+
+func TestFilter(t *testing.T) {
+	ch := IntRange(0, 0, elementCount)
+
+	actualCount := 0
+	for value := range Filter(ch, isEven) {
+		assert.True(t, isEven(value))
+		actualCount++
+	}
+
+	assert.Equal(t, elementCount/2, actualCount)
+}
+
+func TestFilterUntil(t *testing.T) {
+	t.Run("no cancellation", func(t *testing.T) {
+		ch := IntRange(0, 0, elementCount)
+
+		actualCount := 0
+		for value := range FilterUntil(context.Background().Done(), ch, isEven) {
+			assert.True(t, isEven(value))
+			actualCount++
+		}
+
+		assert.Equal(t, elementCount/2, actualCount)
+	})
+
+	t.Run("with cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		ch := Infinite(0, 2)
+		actualCount := 0
+		for range FilterUntil(ctx.Done(), ch, isEven) {
+			actualCount++
+		}
+
+		assert.Equal(t, 0, actualCount)
+	})
+}
+
+func TestZip(t *testing.T) {
+	a := IntRange(0, 0, elementCount)
+	b := StringIntRange(0, 0, elementCount/2)
+
+	actualCount := 0
+	for pair := range Zip(a, b) {
+		assert.Equal(t, pair.First, actualCount)
+		actualCount++
+	}
+
+	assert.Equal(t, elementCount/2, actualCount)
+}
+
+func TestZipUntil(t *testing.T) {
+	a := IntRange(0, 0, elementCount)
+	b := StringIntRange(0, 0, elementCount)
+
+	actualCount := 0
+	for pair := range ZipUntil(context.Background().Done(), a, b) {
+		assert.Equal(t, pair.First, actualCount)
+		actualCount++
+	}
+
+	assert.Equal(t, elementCount, actualCount)
+}
+
+func TestSplit(t *testing.T) {
+	ch := IntRange(0, 0, elementCount)
+
+	outs := Split(ch, 3)
+	assert.Len(t, outs, 3)
+
+	sums := make([]int, 3)
+	var wait sync.WaitGroup
+	for i, out := range outs {
+		wait.Add(1)
+		go func(i int, out <-chan int) {
+			defer wait.Done()
+			for value := range out {
+				sums[i] += value
+			}
+		}(i, out)
+	}
+	wait.Wait()
+
+	expectedSum := elementCount * (elementCount - 1) / 2
+	for _, sum := range sums {
+		assert.Equal(t, expectedSum, sum)
+	}
+}
+
+func TestSplitUntil(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := Infinite(0, 1)
+	outs := SplitUntil(ctx.Done(), ch, 2)
+
+	go func() {
+		for range outs[1] {
+		}
+	}()
+
+	actualCount := 0
+	for range outs[0] {
+		actualCount++
+		if actualCount == elementCount {
+			cancel()
+		}
+	}
+
+	assert.GreaterOrEqual(t, actualCount, elementCount)
+}
+
+func TestScan(t *testing.T) {
+	ch := IntRange(0, 1, 4)
+
+	var results []int
+	for result := range Scan(ch, 0, func(x int, sum int) int { return sum + x }) {
+		results = append(results, result)
+	}
+
+	assert.Equal(t, []int{1, 3, 6, 10}, results)
+}
+
+func TestScanUntil(t *testing.T) {
+	ch := IntRange(0, 1, 4)
+
+	var results []int
+	for result := range ScanUntil(context.Background().Done(), ch, 0, func(x int, sum int) int { return sum + x }) {
+		results = append(results, result)
+	}
+
+	assert.Equal(t, []int{1, 3, 6, 10}, results)
+}
@@ -0,0 +1,112 @@
+package channels
+
+import "context"
+
+// TryMap applies f to each element in ch and wraps the result in a Maybe,
+// capturing any error returned by f instead of losing it or panicking.
+func TryMap[A any, B any](ch <-chan A, f func(A) (B, error)) <-chan Maybe[B] {
+	return Map(ch, func(value A) Maybe[B] {
+		result, err := f(value)
+		if err != nil {
+			return MaybeError[B](err)
+		}
+		return MaybeValue(result)
+	})
+}
+
+// TryBind maps each element of ch to a new channel using f, then flattens
+// the result into a single channel of Maybe values, capturing any error
+// returned by f instead of losing it or panicking.
+func TryBind[A any, B any](ch <-chan A, f func(A) (<-chan B, error)) <-chan Maybe[B] {
+	return Bind(ch, func(value A) <-chan Maybe[B] {
+		inner, err := f(value)
+		if err != nil {
+			return Just(MaybeError[B](err))
+		}
+		return Map(inner, MaybeValue[B])
+	})
+}
+
+// Partition splits a channel of Maybe values into a channel of successful
+// values and a channel of errors. Both channels are unbuffered and closed
+// together once ch is closed, so a caller must drain both concurrently
+// (for example with a select loop) to avoid deadlocking on whichever one
+// it ignores.
+func Partition[A any](ch <-chan Maybe[A]) (<-chan A, <-chan error) {
+	values := make(chan A)
+	errs := make(chan error)
+
+	go func() {
+		defer close(values)
+		defer close(errs)
+
+		for m := range ch {
+			value, err := m.Result()
+			if err != nil {
+				errs <- err
+			} else {
+				values <- value
+			}
+		}
+	}()
+
+	return values, errs
+}
+
+// FirstError returns a channel of the successful values produced by ch,
+// along with a derived context that is cancelled as soon as ch produces a
+// Maybe holding an error (or when the parent context is done). Consumers
+// can use the returned context to stop any upstream work as soon as the
+// pipeline should fail fast. The context is also cancelled once ch is
+// closed and every value has been delivered, so a cancelled context alone
+// does not mean that an error occurred.
+func FirstError[A any](ctx context.Context, ch <-chan Maybe[A]) (context.Context, <-chan A) {
+	ctx, cancel := context.WithCancel(ctx)
+	out := make(chan A)
+
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case m, ok := <-ch:
+				if !ok {
+					return
+				}
+				value, err := m.Result()
+				if err != nil {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case out <- value:
+				}
+			}
+		}
+	}()
+
+	return ctx, out
+}
+
+// Collect drains ch into a slice, returning the accumulated values and a
+// nil error if every element was successful. It stops and returns the
+// first error encountered, along with the values collected before it.
+// Like Aggregate it reduces the channel to a single result, but Collect
+// blocks and returns that result directly instead of on a channel.
+func Collect[A any](ch <-chan Maybe[A]) ([]A, error) {
+	var values []A
+
+	for m := range ch {
+		value, err := m.Result()
+		if err != nil {
+			return values, err
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
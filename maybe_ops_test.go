@@ -0,0 +1,97 @@
+package channels
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errOdd = errors.New("odd value")
+
+func failOnOdd(x int) (int, error) {
+	if x%2 != 0 {
+		return 0, errOdd
+	}
+	return x, nil
+}
+
+func TestTryMap(t *testing.T) {
+	ch := IntRange(0, 0, elementCount)
+
+	values, err := Collect(TryMap(ch, func(x int) (int, error) { return double(x), nil }))
+
+	assert.NoError(t, err)
+	assert.Len(t, values, elementCount)
+}
+
+func TestTryMapError(t *testing.T) {
+	ch := IntRange(0, 0, elementCount)
+
+	values, err := Collect(TryMap(ch, failOnOdd))
+
+	assert.ErrorIs(t, err, errOdd)
+	assert.Equal(t, []int{0}, values)
+}
+
+func TestPartition(t *testing.T) {
+	ch := IntRange(0, 0, elementCount)
+	maybes := TryMap(ch, failOnOdd)
+
+	values, errs := Partition(maybes)
+
+	var gotValues []int
+	var gotErrs []error
+
+	done := false
+	for !done {
+		select {
+		case value, ok := <-values:
+			if !ok {
+				values = nil
+				break
+			}
+			gotValues = append(gotValues, value)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				break
+			}
+			gotErrs = append(gotErrs, err)
+		}
+		if values == nil && errs == nil {
+			done = true
+		}
+	}
+
+	assert.Len(t, gotValues, elementCount/2)
+	assert.Len(t, gotErrs, elementCount/2)
+}
+
+func TestFirstError(t *testing.T) {
+	ch := IntRange(0, 0, elementCount)
+	maybes := TryMap(ch, failOnOdd)
+
+	ctx, out := FirstError(context.Background(), maybes)
+
+	var values []int
+	for value := range out {
+		values = append(values, value)
+	}
+
+	assert.Equal(t, []int{0}, values)
+	assert.Error(t, ctx.Err())
+}
+
+func TestCollect(t *testing.T) {
+	ch := IntRange(0, 0, elementCount)
+
+	values, err := Collect(TryMap(ch, func(x int) (int, error) { return x, nil }))
+
+	assert.NoError(t, err)
+	assert.Len(t, values, elementCount)
+	for i, value := range values {
+		assert.Equal(t, i, value)
+	}
+}
@@ -0,0 +1,130 @@
+package channels
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// fanInThreshold is the number of input channels above which Merge switches
+// from a goroutine-per-channel fan-in to a single reflect.Select loop, so
+// that combining dozens of producers doesn't require a goroutine per input.
+const fanInThreshold = 8
+
+// Merge fans in an arbitrary number of input channels into a single output
+// channel. The output channel is closed once every input channel has been
+// drained, or the supplied done channel is closed, whichever happens first.
+//
+// Unlike Flatten, which consumes a channel of channels, Merge accepts a
+// static slice of channels known up front. When more than fanInThreshold
+// channels are supplied, Merge multiplexes them with reflect.Select in a
+// single goroutine rather than spawning one goroutine per input, rotating
+// the select order on each iteration so a single high-traffic producer
+// cannot starve the others.
+func Merge[A any](done <-chan struct{}, chans ...<-chan A) <-chan A {
+	out := make(chan A)
+
+	if len(chans) > fanInThreshold {
+		go mergeSelect(done, out, chans)
+	} else {
+		go mergeGoroutines(done, out, chans)
+	}
+
+	return out
+}
+
+// MergeC fans in an arbitrary number of input channels into a single output
+// channel, as Merge does, but is cancelled when the supplied context is done
+// rather than via an explicit done channel.
+func MergeC[A any](ctx context.Context, chans ...<-chan A) <-chan A {
+	return Merge(ctx.Done(), chans...)
+}
+
+func mergeGoroutines[A any](done <-chan struct{}, out chan<- A, chans []<-chan A) {
+	defer close(out)
+	var wait sync.WaitGroup
+
+	for _, ch := range chans {
+		wait.Add(1)
+		go func(ch <-chan A) {
+			defer wait.Done()
+
+			for {
+				select {
+				case <-done:
+					return
+				case value, ok := <-ch:
+					if !ok {
+						return
+					}
+					select {
+					case <-done:
+						return
+					case out <- value:
+					}
+				}
+			}
+		}(ch)
+	}
+
+	wait.Wait()
+}
+
+// mergeSelect multiplexes chans onto out using reflect.Select. Closed
+// channels are dropped from the case list (set to the zero Value, as Go's
+// own channel tests do) so they are excluded from later selects, and the
+// case order is rotated on each iteration for fairness.
+func mergeSelect[A any](done <-chan struct{}, out chan<- A, chans []<-chan A) {
+	defer close(out)
+
+	cases := make([]reflect.SelectCase, len(chans)+1)
+	cases[0] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(done)}
+	for i, ch := range chans {
+		cases[i+1] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)}
+	}
+
+	remaining := len(chans)
+	rotate := 0
+
+	for remaining > 0 {
+		ordered, indexOf := rotateCases(cases, rotate)
+		rotate = (rotate + 1) % len(cases)
+
+		chosen, value, ok := reflect.Select(ordered)
+		i := indexOf[chosen]
+
+		if i == 0 {
+			return
+		}
+
+		if !ok {
+			cases[i].Chan = reflect.Value{}
+			remaining--
+			continue
+		}
+
+		select {
+		case <-done:
+			return
+		case out <- value.Interface().(A):
+		}
+	}
+}
+
+// rotateCases returns a copy of cases rotated left by n positions, along
+// with a mapping from each rotated index back to its original index so the
+// caller can recover which channel a chosen case corresponds to.
+func rotateCases(cases []reflect.SelectCase, n int) ([]reflect.SelectCase, []int) {
+	count := len(cases)
+	n %= count
+	ordered := make([]reflect.SelectCase, count)
+	indexOf := make([]int, count)
+
+	for i := 0; i < count; i++ {
+		src := (i + n) % count
+		ordered[i] = cases[src]
+		indexOf[i] = src
+	}
+
+	return ordered, indexOf
+}
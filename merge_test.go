@@ -0,0 +1,67 @@
+package channels
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	for _, producerCount := range []int{1, 2, fanInThreshold, fanInThreshold + 1, fanInThreshold * 3} {
+		t.Run(fmt.Sprintf("%d producers", producerCount), func(t *testing.T) {
+			perProducer := elementCount / producerCount
+			if perProducer == 0 {
+				perProducer = 1
+			}
+
+			chans := make([]<-chan int, producerCount)
+			for i := range chans {
+				chans[i] = IntRange(0, i*perProducer, perProducer)
+			}
+
+			seen := make(map[int]bool)
+			for value := range Merge(context.Background().Done(), chans...) {
+				seen[value] = true
+			}
+
+			assert.Len(t, seen, producerCount*perProducer)
+		})
+	}
+}
+
+func TestMergeCancellation(t *testing.T) {
+	for _, producerCount := range []int{2, fanInThreshold + 2} {
+		t.Run(fmt.Sprintf("%d producers", producerCount), func(t *testing.T) {
+			chans := make([]<-chan int, producerCount)
+			for i := range chans {
+				chans[i] = Infinite(0, i)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			actualCount := 0
+			for range MergeC(ctx, chans...) {
+				actualCount++
+				if actualCount == elementCount {
+					cancel()
+				}
+			}
+
+			assert.GreaterOrEqual(t, actualCount, elementCount)
+		})
+	}
+}
+
+func TestMergeEmpty(t *testing.T) {
+	ch := Merge[int](context.Background().Done())
+
+	count := 0
+	for range ch {
+		count++
+	}
+
+	assert.Equal(t, 0, count)
+}
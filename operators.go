@@ -222,15 +222,11 @@ func TakeUntil[A any](done <-chan struct{}, ch <-chan A) <-chan A {
 		defer close(out)
 
 		for {
-			select {
-			case <-done:
+			value, _, ok := Select(done, ch)
+			if !ok {
 				return
-			case value, ok := <-ch:
-				if !ok {
-					return
-				}
-				out <- value
 			}
+			out <- value
 		}
 	}()
 	return out
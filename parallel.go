@@ -0,0 +1,174 @@
+package channels
+
+import (
+	"context"
+	"sync"
+)
+
+// MapPar applies f to each element received from ch using a bounded pool of
+// workers, emitting results as they complete rather than in input order.
+// Unlike Map, which applies f to one element at a time in a single
+// goroutine, and Bind, which spawns an unbounded goroutine per input,
+// MapPar bounds concurrency to workers. It is cancelled when the supplied
+// context is done.
+func MapPar[A any, B any](ctx context.Context, ch <-chan A, workers int, f func(context.Context, A) B) <-chan B {
+	out := make(chan B)
+
+	go func() {
+		defer close(out)
+		var wait sync.WaitGroup
+
+		for i := 0; i < workers; i++ {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case value, ok := <-ch:
+						if !ok {
+							return
+						}
+						result := f(ctx, value)
+						select {
+						case <-ctx.Done():
+							return
+						case out <- result:
+						}
+					}
+				}
+			}()
+		}
+
+		wait.Wait()
+	}()
+
+	return out
+}
+
+// BindPar maps each element of ch to a new channel using a bounded pool of
+// workers, then flattens the results into a single output channel. As with
+// MapPar, at most workers calls to f run at once. The channels that f
+// returns are drained by FlattenUntil, which still starts one goroutine per
+// returned channel, so the number of draining goroutines is not bounded by
+// workers. It is cancelled when the supplied context is done.
+func BindPar[A any, B any](ctx context.Context, ch <-chan A, workers int, f func(context.Context, A) <-chan B) <-chan B {
+	inner := MapPar(ctx, ch, workers, f)
+	return FlattenUntil(ctx.Done(), inner)
+}
+
+// MapParOrdered behaves like MapPar but emits results in the same order as
+// the corresponding inputs. Completions that finish out of order are held
+// in a small reorder buffer, bounded in practice by workers, until their
+// turn comes up. It is cancelled when the supplied context is done.
+func MapParOrdered[A any, B any](ctx context.Context, ch <-chan A, workers int, f func(context.Context, A) B) <-chan B {
+	type item struct {
+		seq   int
+		value A
+	}
+	type result struct {
+		seq   int
+		value B
+	}
+
+	// slots limits how many items are numbered but not yet emitted, which
+	// keeps the reorder buffer below workers entries.
+	slots := make(chan struct{}, workers)
+
+	numbered := make(chan item)
+	go func() {
+		defer close(numbered)
+		seq := 0
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case value, ok := <-ch:
+				if !ok {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case slots <- struct{}{}:
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case numbered <- item{seq: seq, value: value}:
+					seq++
+				}
+			}
+		}
+	}()
+
+	results := make(chan result)
+	go func() {
+		defer close(results)
+		var wait sync.WaitGroup
+
+		for i := 0; i < workers; i++ {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+
+				for it := range numbered {
+					value := f(ctx, it.value)
+					select {
+					case <-ctx.Done():
+						return
+					case results <- result{seq: it.seq, value: value}:
+					}
+				}
+			}()
+		}
+
+		wait.Wait()
+	}()
+
+	out := make(chan B)
+	go func() {
+		defer close(out)
+
+		pending := make(map[int]B)
+		next := 0
+
+		emitReady := func() bool {
+			for {
+				value, found := pending[next]
+				if !found {
+					return true
+				}
+				select {
+				case <-ctx.Done():
+					return false
+				case out <- value:
+				}
+				delete(pending, next)
+				next++
+				<-slots
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case r, ok := <-results:
+				if !ok {
+					emitReady()
+					return
+				}
+				pending[r.seq] = r.value
+				if !emitReady() {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,76 @@
+package channels
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapPar(t *testing.T) {
+	for _, workers := range []int{1, 4, elementCount} {
+		ch := IntRange(0, 0, elementCount)
+
+		seen := make(map[int]bool)
+		for value := range MapPar(context.Background(), ch, workers, func(_ context.Context, x int) int {
+			return double(x)
+		}) {
+			seen[value] = true
+		}
+
+		assert.Len(t, seen, elementCount)
+		for i := 0; i < elementCount; i++ {
+			assert.True(t, seen[double(i)])
+		}
+	}
+}
+
+func TestMapParCancellation(t *testing.T) {
+	ch := Infinite(0, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	actualCount := 0
+	for range MapPar(ctx, ch, 4, func(_ context.Context, x int) int { return x }) {
+		actualCount++
+		if actualCount == elementCount {
+			cancel()
+		}
+	}
+
+	assert.GreaterOrEqual(t, actualCount, elementCount)
+}
+
+func TestBindPar(t *testing.T) {
+	ch := IntRange(0, 0, elementCount)
+
+	values := make(map[int]int)
+	testChannel := BindPar(context.Background(), ch, 4, func(_ context.Context, x int) <-chan int {
+		return Repeat(0, x+1, x+1)
+	})
+
+	for value := range testChannel {
+		values[value] += 1
+	}
+
+	assert.Len(t, values, elementCount)
+	for i := 1; i <= elementCount; i++ {
+		assert.Equal(t, i, values[i])
+	}
+}
+
+func TestMapParOrdered(t *testing.T) {
+	for _, workers := range []int{1, 4, elementCount} {
+		ch := IntRange(0, 0, elementCount)
+
+		actualCount := 0
+		for value := range MapParOrdered(context.Background(), ch, workers, func(_ context.Context, x int) int {
+			return double(x)
+		}) {
+			assert.Equal(t, double(actualCount), value)
+			actualCount++
+		}
+
+		assert.Equal(t, elementCount, actualCount)
+	}
+}
@@ -0,0 +1,71 @@
+package channels
+
+import "reflect"
+
+// Select waits for the next value to be ready on any of cases, or for done
+// to be closed, and returns it along with the index of the case it came
+// from. It returns ok=false once done is closed or every case channel has
+// been closed, replacing the repeated
+//
+//	for {
+//		select {
+//		case <-done:
+//			return
+//		case value, ok := <-ch:
+//			if !ok {
+//				return
+//			}
+//			...
+//		}
+//	}
+//
+// boilerplate used throughout this package. Internally it uses
+// reflect.Select and, as Go's own channel tests do, nils out closed
+// channels so they are excluded from the remaining selects of the same
+// call rather than busy looping once one input closes early. Each call
+// starts again from the channels it is given, so a channel that is
+// already closed is received from, and dropped, again on every call.
+func Select[A any](done <-chan struct{}, cases ...<-chan A) (value A, index int, ok bool) {
+	selectCases := make([]reflect.SelectCase, len(cases)+1)
+	selectCases[0] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(done)}
+	for i, ch := range cases {
+		selectCases[i+1] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)}
+	}
+
+	for {
+		select {
+		case <-done:
+			var zero A
+			return zero, -1, false
+		default:
+		}
+
+		chosen, recv, recvOK := reflect.Select(selectCases)
+		if chosen == 0 {
+			var zero A
+			return zero, -1, false
+		}
+
+		if !recvOK {
+			selectCases[chosen].Chan = reflect.Value{}
+			if allClosed(selectCases) {
+				var zero A
+				return zero, -1, false
+			}
+			continue
+		}
+
+		return recv.Interface().(A), chosen - 1, true
+	}
+}
+
+// allClosed reports whether every case channel after the leading done case
+// has been nilled out.
+func allClosed(cases []reflect.SelectCase) bool {
+	for i := 1; i < len(cases); i++ {
+		if cases[i].Chan.IsValid() {
+			return false
+		}
+	}
+	return true
+}
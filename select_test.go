@@ -0,0 +1,49 @@
+package channels
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelect(t *testing.T) {
+	a := IntRange(0, 0, elementCount)
+	b := IntRange(0, elementCount, elementCount)
+
+	seen := make(map[int]int)
+	done := context.Background().Done()
+
+	for {
+		value, index, ok := Select(done, a, b)
+		if !ok {
+			break
+		}
+		seen[index]++
+		_ = value
+	}
+
+	assert.Equal(t, elementCount, seen[0])
+	assert.Equal(t, elementCount, seen[1])
+}
+
+func TestSelectDone(t *testing.T) {
+	done := make(chan struct{})
+	close(done)
+
+	ch := Infinite(0, 1)
+
+	_, index, ok := Select(done, ch)
+
+	assert.False(t, ok)
+	assert.Equal(t, -1, index)
+}
+
+func TestSelectClosedChannel(t *testing.T) {
+	ch := Empty[int]()
+
+	_, index, ok := Select(context.Background().Done(), ch)
+
+	assert.False(t, ok)
+	assert.Equal(t, -1, index)
+}
@@ -0,0 +1,118 @@
+package channels
+
+import "time"
+
+// Tick returns a channel that produces the current time every d, until the
+// supplied done channel is closed.
+func Tick(d time.Duration, done <-chan struct{}) <-chan time.Time {
+	out := make(chan time.Time)
+	ticker := time.NewTicker(d)
+
+	go func() {
+		defer close(out)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case value := <-ticker.C:
+				select {
+				case <-done:
+					return
+				case out <- value:
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// Debounce returns a channel that emits the most recent value from ch only
+// after d has elapsed without ch producing a new value. It closes once ch
+// is closed, flushing any pending value first.
+func Debounce[A any](ch <-chan A, d time.Duration) <-chan A {
+	out := make(chan A)
+
+	go func() {
+		defer close(out)
+
+		var timer *time.Timer
+		var pending A
+		have := false
+
+		for {
+			var timerC <-chan time.Time
+			if timer != nil {
+				timerC = timer.C
+			}
+
+			select {
+			case value, ok := <-ch:
+				if !ok {
+					if have {
+						out <- pending
+					}
+					return
+				}
+				pending = value
+				have = true
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.NewTimer(d)
+			case <-timerC:
+				out <- pending
+				have = false
+				timer = nil
+			}
+		}
+	}()
+
+	return out
+}
+
+// Window batches values from ch into slices, flushing a batch whenever d has
+// elapsed since the batch's first value or the batch reaches maxSize,
+// whichever comes first. It closes once ch is closed, flushing any
+// partially-filled batch first.
+func Window[A any](ch <-chan A, d time.Duration, maxSize int) <-chan []A {
+	out := make(chan []A)
+
+	go func() {
+		defer close(out)
+
+		var batch []A
+		var timerC <-chan time.Time
+
+		flush := func() {
+			if len(batch) > 0 {
+				out <- batch
+				batch = nil
+			}
+			timerC = nil
+		}
+
+		for {
+			select {
+			case value, ok := <-ch:
+				if !ok {
+					flush()
+					return
+				}
+				if len(batch) == 0 {
+					timerC = time.After(d)
+				}
+				batch = append(batch, value)
+				if len(batch) == maxSize {
+					flush()
+				}
+			case <-timerC:
+				flush()
+			}
+		}
+	}()
+
+	return out
+}
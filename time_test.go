@@ -0,0 +1,100 @@
+package channels
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTick(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	ch := Tick(time.Millisecond, done)
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for tick")
+		}
+	}
+}
+
+func TestTickStopsOnDone(t *testing.T) {
+	done := make(chan struct{})
+	ch := Tick(time.Millisecond, done)
+
+	<-ch
+	close(done)
+
+	select {
+	case _, ok := <-ch:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for channel to close")
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	source := make(chan int)
+	out := Debounce(source, 20*time.Millisecond)
+
+	go func() {
+		defer close(source)
+		for i := 0; i < 5; i++ {
+			source <- i
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	select {
+	case value, ok := <-out:
+		assert.True(t, ok)
+		assert.Equal(t, 4, value)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for debounced value")
+	}
+
+	_, ok := <-out
+	assert.False(t, ok)
+}
+
+func TestWindowFlushesOnMaxSize(t *testing.T) {
+	source := make(chan int)
+	out := Window(source, time.Hour, 3)
+
+	go func() {
+		defer close(source)
+		for i := 0; i < 7; i++ {
+			source <- i
+		}
+	}()
+
+	var batches [][]int
+	for batch := range out {
+		batches = append(batches, batch)
+	}
+
+	assert.Equal(t, [][]int{{0, 1, 2}, {3, 4, 5}, {6}}, batches)
+}
+
+func TestWindowFlushesOnDuration(t *testing.T) {
+	source := make(chan int)
+	out := Window(source, 10*time.Millisecond, 100)
+
+	go func() {
+		defer close(source)
+		source <- 1
+		time.Sleep(30 * time.Millisecond)
+		source <- 2
+	}()
+
+	var batches [][]int
+	for batch := range out {
+		batches = append(batches, batch)
+	}
+
+	assert.Equal(t, [][]int{{1}, {2}}, batches)
+}